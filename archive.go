@@ -0,0 +1,191 @@
+package tokbox
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+const apiArchive = "/v2/project/%s/archive"
+
+// OutputMode type - https://tokbox.com/developer/rest/#start_archive
+type OutputMode string
+
+const (
+	// Composed has the archive recorded to a single, composed file
+	Composed OutputMode = "composed"
+	// Individual has the archive recorded to one file per stream
+	Individual OutputMode = "individual"
+)
+
+// String returns the string value of an OutputMode instance
+func (i OutputMode) String() string {
+	return string(i)
+}
+
+// ArchiveStatus represents the lifecycle state of an Archive -
+// https://tokbox.com/developer/rest/#retrieve_archive_info
+type ArchiveStatus string
+
+const (
+	// ArchiveStatusStarted means the archive started and is in progress
+	ArchiveStatusStarted ArchiveStatus = "started"
+	// ArchiveStatusPaused means the archive has no active streams and is paused
+	ArchiveStatusPaused ArchiveStatus = "paused"
+	// ArchiveStatusStopped means the archive has stopped
+	ArchiveStatusStopped ArchiveStatus = "stopped"
+	// ArchiveStatusUploaded means the archive is available for download from the upload target
+	ArchiveStatusUploaded ArchiveStatus = "uploaded"
+	// ArchiveStatusAvailable means the archive is available for download from the OpenTok cloud
+	ArchiveStatusAvailable ArchiveStatus = "available"
+	// ArchiveStatusExpired means the archive's download window expired
+	ArchiveStatusExpired ArchiveStatus = "expired"
+	// ArchiveStatusFailed means the archive recording failed
+	ArchiveStatusFailed ArchiveStatus = "failed"
+	// ArchiveStatusDeleted means the archive was deleted
+	ArchiveStatusDeleted ArchiveStatus = "deleted"
+)
+
+// ArchiveLayout configures how streams are composed in a composed archive -
+// https://tokbox.com/developer/rest/#change-archive-layout
+type ArchiveLayout struct {
+	Type       string `json:"type"`
+	StyleSheet string `json:"stylesheet,omitempty"`
+}
+
+// Archive represents an OpenTok archive -
+// https://tokbox.com/developer/guides/archiving/
+type Archive struct {
+	ID         string        `json:"id"`
+	Status     ArchiveStatus `json:"status"`
+	Name       string        `json:"name,omitempty"`
+	Reason     string        `json:"reason,omitempty"`
+	SessionID  string        `json:"sessionId"`
+	ProjectID  int           `json:"projectId"`
+	CreatedAt  int64         `json:"createdAt"`
+	Duration   int           `json:"duration"`
+	Size       int64         `json:"size"`
+	HasAudio   bool          `json:"hasAudio"`
+	HasVideo   bool          `json:"hasVideo"`
+	OutputMode OutputMode    `json:"outputMode"`
+	Resolution string        `json:"resolution,omitempty"`
+	URL        string        `json:"url,omitempty"`
+}
+
+// StartArchiveRequest provides all information needed to start an archive -
+// https://tokbox.com/developer/rest/#start_archive
+type StartArchiveRequest struct {
+	Name       string
+	HasAudio   *bool
+	HasVideo   *bool
+	OutputMode OutputMode
+	Resolution string
+	Layout     *ArchiveLayout
+}
+
+// ArchiveList is the paginated response returned by ListArchives
+type ArchiveList struct {
+	Count int       `json:"count"`
+	Items []Archive `json:"items"`
+}
+
+// ListArchivesRequest filters the result of ListArchives. All fields are
+// optional; SessionID restricts results to a single session and Offset/Count
+// page through the rest.
+type ListArchivesRequest struct {
+	Offset    int
+	Count     int
+	SessionID string
+}
+
+// StartArchive starts recording the archive of an OpenTok session -
+// https://tokbox.com/developer/rest/#start_archive
+func (t *Tokbox) StartArchive(ctx context.Context, sessionID string, req *StartArchiveRequest) (*Archive, error) {
+	if len(sessionID) == 0 {
+		return nil, fmt.Errorf("Tokbox: sessionID is required to start an archive")
+	}
+
+	body := struct {
+		SessionID  string         `json:"sessionId"`
+		Name       string         `json:"name,omitempty"`
+		HasAudio   *bool          `json:"hasAudio,omitempty"`
+		HasVideo   *bool          `json:"hasVideo,omitempty"`
+		OutputMode OutputMode     `json:"outputMode,omitempty"`
+		Resolution string         `json:"resolution,omitempty"`
+		Layout     *ArchiveLayout `json:"layout,omitempty"`
+	}{SessionID: sessionID}
+
+	if req != nil {
+		body.Name = req.Name
+		body.HasAudio = req.HasAudio
+		body.HasVideo = req.HasVideo
+		body.OutputMode = req.OutputMode
+		body.Resolution = req.Resolution
+		body.Layout = req.Layout
+	}
+
+	var a Archive
+	if err := t.doJSON(ctx, "POST", fmt.Sprintf(apiArchive, t.apiKey), body, &a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// StopArchive stops recording the given archive -
+// https://tokbox.com/developer/rest/#stop_archive
+func (t *Tokbox) StopArchive(ctx context.Context, archiveID string) (*Archive, error) {
+	var a Archive
+	path := fmt.Sprintf(apiArchive, t.apiKey) + "/" + archiveID + "/stop"
+	if err := t.doJSON(ctx, "POST", path, nil, &a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// GetArchive retrieves a single archive by ID -
+// https://tokbox.com/developer/rest/#retrieve_archive_info
+func (t *Tokbox) GetArchive(ctx context.Context, archiveID string) (*Archive, error) {
+	var a Archive
+	path := fmt.Sprintf(apiArchive, t.apiKey) + "/" + archiveID
+	if err := t.doJSON(ctx, "GET", path, nil, &a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// ListArchives lists archives for the project, optionally filtered by
+// session and paginated with Offset/Count -
+// https://tokbox.com/developer/rest/#list_archives
+func (t *Tokbox) ListArchives(ctx context.Context, req *ListArchivesRequest) (*ArchiveList, error) {
+	path := fmt.Sprintf(apiArchive, t.apiKey)
+
+	if req != nil {
+		params := url.Values{}
+		if req.Offset > 0 {
+			params.Add("offset", strconv.Itoa(req.Offset))
+		}
+		if req.Count > 0 {
+			params.Add("count", strconv.Itoa(req.Count))
+		}
+		if len(req.SessionID) > 0 {
+			params.Add("sessionId", req.SessionID)
+		}
+		if len(params) > 0 {
+			path += "?" + params.Encode()
+		}
+	}
+
+	var l ArchiveList
+	if err := t.doJSON(ctx, "GET", path, nil, &l); err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+// DeleteArchive permanently deletes an archive -
+// https://tokbox.com/developer/rest/#delete_archive
+func (t *Tokbox) DeleteArchive(ctx context.Context, archiveID string) error {
+	path := fmt.Sprintf(apiArchive, t.apiKey) + "/" + archiveID
+	return t.doJSON(ctx, "DELETE", path, nil, nil)
+}
@@ -0,0 +1,98 @@
+package tokbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestStartArchiveSuccess(t *testing.T) {
+	var gotPath string
+	var gotBody map[string]interface{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatal(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Archive{
+			ID:         "ARCHIVEID",
+			Status:     ArchiveStatusStarted,
+			SessionID:  "SESSIONID",
+			OutputMode: Composed,
+		})
+	}))
+	defer srv.Close()
+
+	tb := newTestClient(srv)
+	hasAudio := true
+	a, err := tb.StartArchive(context.Background(), "SESSIONID", &StartArchiveRequest{
+		Name:     "my-archive",
+		HasAudio: &hasAudio,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := "/v2/project/key/archive"; gotPath != want {
+		t.Fatalf("expected path %q, got %q", want, gotPath)
+	}
+	if gotBody["sessionId"] != "SESSIONID" {
+		t.Fatalf("expected sessionId in request body, got %v", gotBody["sessionId"])
+	}
+	if gotBody["hasAudio"] != true {
+		t.Fatalf("expected hasAudio=true in request body, got %v", gotBody["hasAudio"])
+	}
+	if a.ID != "ARCHIVEID" || a.Status != ArchiveStatusStarted {
+		t.Fatalf("unexpected archive returned: %+v", a)
+	}
+}
+
+func TestStartArchiveRequiresSessionID(t *testing.T) {
+	tb := New("key", "secret")
+
+	if _, err := tb.StartArchive(context.Background(), "", nil); err == nil {
+		t.Fatal("expected an error when sessionID is empty, got nil")
+	}
+}
+
+func TestListArchivesBuildsQueryString(t *testing.T) {
+	var gotQuery string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ArchiveList{Count: 0, Items: []Archive{}})
+	}))
+	defer srv.Close()
+
+	tb := newTestClient(srv)
+	if _, err := tb.ListArchives(context.Background(), &ListArchivesRequest{Offset: 5, Count: 10, SessionID: "SESSIONID"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	values, err := url.ParseQuery(gotQuery)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values.Get("offset") != "5" || values.Get("count") != "10" || values.Get("sessionId") != "SESSIONID" {
+		t.Fatalf("expected offset/count/sessionId in query string, got %q", gotQuery)
+	}
+}
+
+func TestDeleteArchiveErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	tb := newTestClient(srv)
+	if err := tb.DeleteArchive(context.Background(), "ARCHIVEID"); err == nil {
+		t.Fatal("expected an error for a 404 response, got nil")
+	}
+}
+
@@ -0,0 +1,161 @@
+package tokbox
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+const apiBroadcast = "/v2/project/%s/broadcast"
+
+// BroadcastLayoutType selects how streams are arranged in a broadcast -
+// https://tokbox.com/developer/rest/#change-broadcast-layout
+type BroadcastLayoutType string
+
+const (
+	// BestFit arranges streams automatically to best fit the available space
+	BestFit BroadcastLayoutType = "bestFit"
+	// Pip arranges streams with one picture-in-picture over another
+	Pip BroadcastLayoutType = "pip"
+	// VerticalPresentation arranges streams in a vertical presentation layout
+	VerticalPresentation BroadcastLayoutType = "verticalPresentation"
+	// HorizontalPresentation arranges streams in a horizontal presentation layout
+	HorizontalPresentation BroadcastLayoutType = "horizontalPresentation"
+	// CustomLayout arranges streams according to a caller-supplied stylesheet
+	CustomLayout BroadcastLayoutType = "custom"
+)
+
+// String returns the string value of a BroadcastLayoutType instance
+func (i BroadcastLayoutType) String() string {
+	return string(i)
+}
+
+// BroadcastLayout configures how streams are composed in a broadcast
+type BroadcastLayout struct {
+	Type       BroadcastLayoutType `json:"type"`
+	StyleSheet string              `json:"stylesheet,omitempty"`
+}
+
+// RtmpTarget describes a single RTMP destination for a broadcast
+type RtmpTarget struct {
+	ID         string `json:"id,omitempty"`
+	ServerURL  string `json:"serverUrl"`
+	StreamName string `json:"streamName"`
+	Status     string `json:"status,omitempty"`
+}
+
+// BroadcastOutputs selects the broadcast output protocols -
+// https://tokbox.com/developer/rest/#start_broadcast
+type BroadcastOutputs struct {
+	Hls  bool         `json:"hls,omitempty"`
+	Rtmp []RtmpTarget `json:"rtmp,omitempty"`
+}
+
+// BroadcastOptions provides all information needed to start a broadcast
+type BroadcastOptions struct {
+	Layout      *BroadcastLayout
+	Outputs     BroadcastOutputs
+	MaxDuration int
+	Resolution  string
+}
+
+// BroadcastURLs carries the playback URLs for an active broadcast
+type BroadcastURLs struct {
+	Hls  string       `json:"hls,omitempty"`
+	Rtmp []RtmpTarget `json:"rtmp,omitempty"`
+}
+
+// Broadcast represents an OpenTok live streaming broadcast -
+// https://tokbox.com/developer/guides/broadcast/
+type Broadcast struct {
+	ID            string        `json:"id"`
+	SessionID     string        `json:"sessionId"`
+	ProjectID     int           `json:"projectId"`
+	Status        string        `json:"status"`
+	Resolution    string        `json:"resolution,omitempty"`
+	BroadcastUrls BroadcastURLs `json:"broadcastUrls"`
+	CreatedAt     int64         `json:"createdAt"`
+	UpdatedAt     int64         `json:"updatedAt"`
+}
+
+// BroadcastList is the response returned by ListBroadcasts
+type BroadcastList struct {
+	Count int         `json:"count"`
+	Items []Broadcast `json:"items"`
+}
+
+// StartBroadcast starts a live streaming broadcast for an OpenTok session -
+// https://tokbox.com/developer/rest/#start_broadcast
+func (t *Tokbox) StartBroadcast(ctx context.Context, sessionID string, opts *BroadcastOptions) (*Broadcast, error) {
+	if len(sessionID) == 0 {
+		return nil, fmt.Errorf("Tokbox: sessionID is required to start a broadcast")
+	}
+
+	body := struct {
+		SessionID   string           `json:"sessionId"`
+		Layout      *BroadcastLayout `json:"layout,omitempty"`
+		Outputs     BroadcastOutputs `json:"outputs"`
+		MaxDuration int              `json:"maxDuration,omitempty"`
+		Resolution  string           `json:"resolution,omitempty"`
+	}{SessionID: sessionID}
+
+	if opts != nil {
+		body.Layout = opts.Layout
+		body.Outputs = opts.Outputs
+		body.MaxDuration = opts.MaxDuration
+		body.Resolution = opts.Resolution
+	}
+
+	var b Broadcast
+	if err := t.doJSON(ctx, "POST", fmt.Sprintf(apiBroadcast, t.apiKey), body, &b); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// StopBroadcast stops the given broadcast -
+// https://tokbox.com/developer/rest/#stop_broadcast
+func (t *Tokbox) StopBroadcast(ctx context.Context, broadcastID string) (*Broadcast, error) {
+	var b Broadcast
+	path := fmt.Sprintf(apiBroadcast, t.apiKey) + "/" + broadcastID + "/stop"
+	if err := t.doJSON(ctx, "POST", path, nil, &b); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// GetBroadcast retrieves a single broadcast by ID -
+// https://tokbox.com/developer/rest/#get_broadcast_info
+func (t *Tokbox) GetBroadcast(ctx context.Context, broadcastID string) (*Broadcast, error) {
+	var b Broadcast
+	path := fmt.Sprintf(apiBroadcast, t.apiKey) + "/" + broadcastID
+	if err := t.doJSON(ctx, "GET", path, nil, &b); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// ListBroadcasts lists broadcasts for the project, optionally filtered by
+// session - https://tokbox.com/developer/rest/#list_broadcasts
+func (t *Tokbox) ListBroadcasts(ctx context.Context, sessionID string) (*BroadcastList, error) {
+	path := fmt.Sprintf(apiBroadcast, t.apiKey)
+
+	if len(sessionID) > 0 {
+		params := url.Values{}
+		params.Add("sessionId", sessionID)
+		path += "?" + params.Encode()
+	}
+
+	var l BroadcastList
+	if err := t.doJSON(ctx, "GET", path, nil, &l); err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+// SetBroadcastLayout changes the layout of an in-progress broadcast -
+// https://tokbox.com/developer/rest/#change-broadcast-layout
+func (t *Tokbox) SetBroadcastLayout(ctx context.Context, broadcastID string, layout BroadcastLayout) error {
+	path := fmt.Sprintf(apiBroadcast, t.apiKey) + "/" + broadcastID + "/layout"
+	return t.doJSON(ctx, "PUT", path, layout, nil)
+}
@@ -0,0 +1,85 @@
+package tokbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStartBroadcastSuccess(t *testing.T) {
+	var gotBody map[string]interface{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatal(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Broadcast{
+			ID:        "BROADCASTID",
+			SessionID: "SESSIONID",
+			Status:    "started",
+			BroadcastUrls: BroadcastURLs{
+				Hls: "https://example.com/stream.m3u8",
+			},
+		})
+	}))
+	defer srv.Close()
+
+	tb := newTestClient(srv)
+	b, err := tb.StartBroadcast(context.Background(), "SESSIONID", &BroadcastOptions{
+		Layout: &BroadcastLayout{Type: BestFit},
+		Outputs: BroadcastOutputs{
+			Hls: true,
+			Rtmp: []RtmpTarget{
+				{ID: "foo", ServerURL: "rtmp://example.com/live", StreamName: "stream"},
+			},
+		},
+		MaxDuration: 3600,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotBody["sessionId"] != "SESSIONID" {
+		t.Fatalf("expected sessionId in request body, got %v", gotBody["sessionId"])
+	}
+	outputs, ok := gotBody["outputs"].(map[string]interface{})
+	if !ok || outputs["hls"] != true {
+		t.Fatalf("expected outputs.hls=true in request body, got %v", gotBody["outputs"])
+	}
+	rtmp, ok := outputs["rtmp"].([]interface{})
+	if !ok || len(rtmp) != 1 {
+		t.Fatalf("expected one rtmp target in request body, got %v", outputs["rtmp"])
+	}
+	target := rtmp[0].(map[string]interface{})
+	if target["serverUrl"] != "rtmp://example.com/live" || target["streamName"] != "stream" {
+		t.Fatalf("unexpected rtmp target in request body: %v", target)
+	}
+
+	if b.ID != "BROADCASTID" || b.BroadcastUrls.Hls != "https://example.com/stream.m3u8" {
+		t.Fatalf("unexpected broadcast returned: %+v", b)
+	}
+}
+
+func TestStartBroadcastRequiresSessionID(t *testing.T) {
+	tb := New("key", "secret")
+
+	if _, err := tb.StartBroadcast(context.Background(), "", nil); err == nil {
+		t.Fatal("expected an error when sessionID is empty, got nil")
+	}
+}
+
+func TestSetBroadcastLayoutError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	tb := newTestClient(srv)
+	err := tb.SetBroadcastLayout(context.Background(), "BROADCASTID", BroadcastLayout{Type: Pip})
+	if err == nil {
+		t.Fatal("expected an error for a 400 response, got nil")
+	}
+}
@@ -0,0 +1,105 @@
+package tokbox
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how a Tokbox client retries requests that fail with a
+// 429 or 5xx response.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of additional attempts made after the
+	// initial request. Zero disables retries.
+	MaxRetries int
+	// MinBackoff is the delay before the first retry.
+	MinBackoff time.Duration
+	// MaxBackoff caps the exponential backoff, which doubles on each attempt
+	// up to this ceiling. Jitter of up to half the delay is added on top, and
+	// a Retry-After header replaces the backoff for that attempt, so the
+	// actual wait can exceed MaxBackoff.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy retries up to 3 times with exponential backoff between
+// 200ms and 5s.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	MinBackoff: 200 * time.Millisecond,
+	MaxBackoff: 5 * time.Second,
+}
+
+// send performs r using t's configured *http.Client, retrying on 5xx and 429
+// responses (honoring Retry-After) with exponential backoff and jitter.
+// Retries only happen for idempotent methods: a 5xx/429 on a POST (e.g.
+// StartArchive, StartBroadcast, Dial, Signal) may mean the side effect
+// already happened server-side, so blindly retrying it risks duplicating
+// it. GET/HEAD/PUT/DELETE/OPTIONS are safe to repeat and are retried;
+// everything else, including POST, is returned to the caller as-is.
+func (t *Tokbox) send(ctx context.Context, r *http.Request) (*http.Response, error) {
+	r = r.WithContext(ctx)
+	r.Header.Set("User-Agent", t.userAgent)
+
+	retryable := isIdempotent(r.Method)
+	backoff := t.retry.MinBackoff
+
+	for attempt := 0; ; attempt++ {
+		res, err := t.httpClient.Do(r)
+		if err == nil && !shouldRetry(res.StatusCode) {
+			return res, nil
+		}
+		if !retryable || attempt >= t.retry.MaxRetries {
+			return res, err
+		}
+
+		wait := backoff
+		if res != nil {
+			if ra := res.Header.Get("Retry-After"); len(ra) > 0 {
+				if secs, perr := strconv.Atoi(ra); perr == nil {
+					wait = time.Duration(secs) * time.Second
+				}
+			}
+			res.Body.Close()
+		}
+		wait += time.Duration(rand.Int63n(int64(wait/2 + 1))) // jitter
+
+		if t.logger != nil {
+			t.logger.Printf("tokbox: retrying request (attempt %d/%d) after %v", attempt+1, t.retry.MaxRetries, wait)
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		if r.GetBody != nil {
+			body, berr := r.GetBody()
+			if berr != nil {
+				return nil, berr
+			}
+			r.Body = body
+		}
+
+		if backoff *= 2; backoff > t.retry.MaxBackoff {
+			backoff = t.retry.MaxBackoff
+		}
+	}
+}
+
+func shouldRetry(statusCode int) bool {
+	return statusCode == 429 || statusCode >= 500
+}
+
+// isIdempotent reports whether method can be safely repeated without risking
+// a duplicate side effect.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,132 @@
+package tokbox
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestClient(srv *httptest.Server) *Tokbox {
+	return New("key", "secret",
+		WithBaseURL(srv.URL),
+		WithRetry(RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}),
+	)
+}
+
+func TestSendRetriesIdempotentMethodOn5xx(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	tb := newTestClient(srv)
+	r, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := tb.send(context.Background(), r)
+	if err != nil {
+		t.Fatalf("send() returned error: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("expected final status 200, got %v", res.StatusCode)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Fatalf("expected 2 attempts for a GET, got %v", got)
+	}
+}
+
+func TestSendDoesNotRetryPostOn5xx(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	tb := newTestClient(srv)
+	r, err := http.NewRequest(http.MethodPost, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := tb.send(context.Background(), r)
+	if err != nil {
+		t.Fatalf("send() returned error: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected the 500 to be surfaced, got %v", res.StatusCode)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Fatalf("expected a non-idempotent POST to be attempted exactly once, got %v", got)
+	}
+}
+
+func TestSendHonorsRetryAfter(t *testing.T) {
+	var attempts int32
+	var gotWait time.Duration
+	var lastAttempt time.Time
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			lastAttempt = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		gotWait = time.Since(lastAttempt)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	tb := New("key", "secret",
+		WithBaseURL(srv.URL),
+		WithRetry(RetryPolicy{MaxRetries: 1, MinBackoff: time.Millisecond, MaxBackoff: time.Second}),
+	)
+
+	r, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := tb.send(context.Background(), r)
+	if err != nil {
+		t.Fatalf("send() returned error: %v", err)
+	}
+	defer res.Body.Close()
+
+	if gotWait < time.Second {
+		t.Fatalf("expected send to wait at least the Retry-After value (1s), waited %v", gotWait)
+	}
+}
+
+func TestIsIdempotent(t *testing.T) {
+	cases := map[string]bool{
+		http.MethodGet:     true,
+		http.MethodHead:    true,
+		http.MethodPut:     true,
+		http.MethodDelete:  true,
+		http.MethodOptions: true,
+		http.MethodPost:    false,
+		http.MethodPatch:   false,
+	}
+
+	for method, want := range cases {
+		if got := isIdempotent(method); got != want {
+			t.Errorf("isIdempotent(%v) = %v, want %v", method, got, want)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package tokbox
+
+import (
+	"context"
+	"fmt"
+)
+
+const apiDial = "/v2/project/%s/dial"
+
+// SIPAuth carries the credentials used to authenticate with the SIP gateway.
+type SIPAuth struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// DialOptions configures a SIP interconnect call made with Dial -
+// https://tokbox.com/developer/rest/#sip_call
+type DialOptions struct {
+	// From is the number or address that appears as the caller in the SIP call
+	From string
+	// Headers are custom SIP headers sent with the INVITE request
+	Headers map[string]string
+	// Auth carries the SIP endpoint's basic auth credentials
+	Auth *SIPAuth
+	// Secure indicates whether the SIP call should be made over TLS
+	Secure bool
+	// Video indicates whether the SIP call should include video
+	Video bool
+	// ObserveForceMute indicates whether the SIP end should honor force mute
+	ObserveForceMute bool
+}
+
+// DialConnection is the connection created to bridge a session to a SIP endpoint
+type DialConnection struct {
+	ID           string `json:"id"`
+	ConnectionID string `json:"connectionId"`
+	StreamID     string `json:"streamId"`
+}
+
+// Dial bridges an OpenTok session to a SIP endpoint -
+// https://tokbox.com/developer/guides/sip/
+func (t *Tokbox) Dial(ctx context.Context, sessionID, token, sipURI string, opts *DialOptions) (*DialConnection, error) {
+	if len(sessionID) == 0 {
+		return nil, fmt.Errorf("Tokbox: sessionID is required to dial")
+	}
+	if len(token) == 0 {
+		return nil, fmt.Errorf("Tokbox: token is required to dial")
+	}
+	if len(sipURI) == 0 {
+		return nil, fmt.Errorf("Tokbox: sipURI is required to dial")
+	}
+
+	sipPayload := struct {
+		URI              string            `json:"uri"`
+		From             string            `json:"from,omitempty"`
+		Headers          map[string]string `json:"headers,omitempty"`
+		Auth             *SIPAuth          `json:"auth,omitempty"`
+		Secure           bool              `json:"secure,omitempty"`
+		Video            bool              `json:"video,omitempty"`
+		ObserveForceMute bool              `json:"observeForceMute,omitempty"`
+	}{URI: sipURI}
+
+	if opts != nil {
+		sipPayload.From = opts.From
+		sipPayload.Headers = opts.Headers
+		sipPayload.Auth = opts.Auth
+		sipPayload.Secure = opts.Secure
+		sipPayload.Video = opts.Video
+		sipPayload.ObserveForceMute = opts.ObserveForceMute
+	}
+
+	body := struct {
+		SessionID string      `json:"sessionId"`
+		Token     string      `json:"token"`
+		SIP       interface{} `json:"sip"`
+	}{SessionID: sessionID, Token: token, SIP: sipPayload}
+
+	var c DialConnection
+	if err := t.doJSON(ctx, "POST", fmt.Sprintf(apiDial, t.apiKey), body, &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
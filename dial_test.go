@@ -0,0 +1,68 @@
+package tokbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDialSuccess(t *testing.T) {
+	var gotBody map[string]interface{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatal(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(DialConnection{
+			ID:           "DIALID",
+			ConnectionID: "CONNECTIONID",
+			StreamID:     "STREAMID",
+		})
+	}))
+	defer srv.Close()
+
+	tb := newTestClient(srv)
+	c, err := tb.Dial(context.Background(), "SESSIONID", "TOKEN", "sip:user@example.com", &DialOptions{
+		From:   "+15551234567",
+		Secure: true,
+		Video:  true,
+		Auth:   &SIPAuth{Username: "user", Password: "pass"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotBody["sessionId"] != "SESSIONID" || gotBody["token"] != "TOKEN" {
+		t.Fatalf("expected sessionId/token in request body, got %v", gotBody)
+	}
+
+	sip, ok := gotBody["sip"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected a sip object in request body, got %v", gotBody["sip"])
+	}
+	if sip["uri"] != "sip:user@example.com" {
+		t.Fatalf("expected sip.uri to be set, got %v", sip["uri"])
+	}
+	if sip["secure"] != true || sip["video"] != true {
+		t.Fatalf("expected sip.secure/sip.video to be true, got %v", sip)
+	}
+	auth, ok := sip["auth"].(map[string]interface{})
+	if !ok || auth["username"] != "user" || auth["password"] != "pass" {
+		t.Fatalf("expected sip.auth to carry the SIPAuth credentials, got %v", sip["auth"])
+	}
+
+	if c.ID != "DIALID" || c.ConnectionID != "CONNECTIONID" || c.StreamID != "STREAMID" {
+		t.Fatalf("unexpected DialConnection returned: %+v", c)
+	}
+}
+
+func TestDialRequiresSipURI(t *testing.T) {
+	tb := New("key", "secret")
+
+	if _, err := tb.Dial(context.Background(), "SESSIONID", "TOKEN", "", nil); err == nil {
+		t.Fatal("expected an error when sipURI is empty, got nil")
+	}
+}
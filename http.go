@@ -0,0 +1,58 @@
+package tokbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// doJSON performs an authenticated JSON request against the Tokbox REST API.
+// reqBody, if non-nil, is marshaled as the request body; respBody, if
+// non-nil, receives the decoded JSON response. A non-2xx response is
+// reported as an error.
+func (t *Tokbox) doJSON(ctx context.Context, method, path string, reqBody, respBody interface{}) error {
+	var body *bytes.Reader
+	if reqBody != nil {
+		b, err := json.Marshal(reqBody)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	} else {
+		body = bytes.NewReader(nil)
+	}
+
+	r, err := http.NewRequest(method, t.endpoint()+path, body)
+	if err != nil {
+		return err
+	}
+
+	if reqBody != nil {
+		r.Header.Add("Content-Type", "application/json")
+	}
+	r.Header.Add("Accept", "application/json")
+
+	jwt, err := t.jwtToken()
+	if err != nil {
+		return err
+	}
+	r.Header.Add("X-OPENTOK-AUTH", jwt)
+
+	res, err := t.send(ctx, r)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("Tokbox returns error code: %v", res.StatusCode)
+	}
+
+	if respBody == nil {
+		return nil
+	}
+
+	return json.NewDecoder(res.Body).Decode(respBody)
+}
@@ -0,0 +1,148 @@
+package tokbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type doJSONResponse struct {
+	Foo string `json:"foo"`
+}
+
+func TestDoJSONDecodesSuccessResponse(t *testing.T) {
+	var gotMethod, gotPath, gotAuth, gotContentType string
+	var gotBody map[string]string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("X-OPENTOK-AUTH")
+		gotContentType = r.Header.Get("Content-Type")
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatal(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(doJSONResponse{Foo: "bar"})
+	}))
+	defer srv.Close()
+
+	tb := newTestClient(srv)
+
+	var out doJSONResponse
+	err := tb.doJSON(context.Background(), http.MethodPost, "/v2/project/key/thing", map[string]string{"a": "b"}, &out)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotMethod != http.MethodPost {
+		t.Fatalf("expected method POST, got %v", gotMethod)
+	}
+	if gotPath != "/v2/project/key/thing" {
+		t.Fatalf("expected path /v2/project/key/thing, got %v", gotPath)
+	}
+	if len(gotAuth) == 0 {
+		t.Fatal("expected X-OPENTOK-AUTH header to be set")
+	}
+	if gotContentType != "application/json" {
+		t.Fatalf("expected Content-Type application/json, got %v", gotContentType)
+	}
+	if gotBody["a"] != "b" {
+		t.Fatalf("expected request body to be marshaled, got %v", gotBody)
+	}
+	if out.Foo != "bar" {
+		t.Fatalf("expected response body to be decoded, got %+v", out)
+	}
+}
+
+func TestDoJSONNoRequestBody(t *testing.T) {
+	var gotContentType string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentType = r.Header.Get("Content-Type")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	tb := newTestClient(srv)
+	if err := tb.doJSON(context.Background(), http.MethodDelete, "/v2/project/key/thing/1", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(gotContentType) != 0 {
+		t.Fatalf("expected no Content-Type header without a request body, got %v", gotContentType)
+	}
+}
+
+func TestDoJSONReturnsErrorOnNon2xx(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	tb := newTestClient(srv)
+	err := tb.doJSON(context.Background(), http.MethodGet, "/v2/project/key/thing", nil, nil)
+	if err == nil {
+		t.Fatal("expected an error for a 400 response, got nil")
+	}
+}
+
+func TestCreateSessionSuccess(t *testing.T) {
+	var gotPath, gotContentType string
+	var gotBody string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotContentType = r.Header.Get("Content-Type")
+		buf := make([]byte, r.ContentLength)
+		r.Body.Read(buf)
+		gotBody = string(buf)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]Session{{SessionID: "SESSIONID", ProjectID: "key"}})
+	}))
+	defer srv.Close()
+
+	tb := newTestClient(srv)
+	s, err := tb.CreateSession(context.Background(), &CreateSessionRequest{MediaMode: P2P, ArchiveMode: ArchiveModeAlways})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotPath != apiSession {
+		t.Fatalf("expected path %q, got %q", apiSession, gotPath)
+	}
+	if gotContentType != "application/x-www-form-urlencoded" {
+		t.Fatalf("expected form-encoded Content-Type, got %v", gotContentType)
+	}
+	if !contains(gotBody, "archiveMode=always") {
+		t.Fatalf("expected archiveMode=always in the request body, got %q", gotBody)
+	}
+
+	if s.SessionID != "SESSIONID" || s.T != tb {
+		t.Fatalf("unexpected session returned: %+v", s)
+	}
+}
+
+func TestCreateSessionErrorOnNon200(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	tb := newTestClient(srv)
+	_, err := tb.CreateSession(context.Background(), &CreateSessionRequest{})
+	if err == nil {
+		t.Fatal("expected an error for a 500 response, got nil")
+	}
+}
+
+func contains(s, substr string) bool {
+	for i := 0; i+len(substr) <= len(s); i++ {
+		if s[i:i+len(substr)] == substr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package tokbox
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/myesui/uuid"
+)
+
+const (
+	// defaultJWTLifetime is used when WithJWTLifetime is not set.
+	defaultJWTLifetime = 3 * time.Minute
+	// maxJWTLifetime is the maximum expiration window the OpenTok REST API
+	// accepts for a project JWT.
+	maxJWTLifetime = 5 * time.Minute
+)
+
+// WithJWTLifetime sets how long each signed project JWT is valid for. It is
+// capped at 5 minutes, the maximum expiration window the OpenTok REST API
+// accepts; longer values are silently reduced to the cap.
+func WithJWTLifetime(d time.Duration) Option {
+	return func(t *Tokbox) {
+		if d > maxJWTLifetime {
+			d = maxJWTLifetime
+		}
+		t.jwtLifetime = d
+	}
+}
+
+// jwtTokenSource signs and caches the project JWT used to authenticate
+// requests against the OpenTok REST API, reusing it until it is close to
+// expiry. This mirrors the oauth2.ReuseTokenSource pattern: callers always
+// go through Token and never see the signing details.
+type jwtTokenSource struct {
+	apiKey   string
+	secret   string
+	lifetime time.Duration
+	method   jwt.SigningMethod // HS256 today; room to plug in RS256 later
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+func newJWTTokenSource(apiKey, secret string, lifetime time.Duration) *jwtTokenSource {
+	if lifetime <= 0 {
+		lifetime = defaultJWTLifetime
+	}
+	if lifetime > maxJWTLifetime {
+		lifetime = maxJWTLifetime
+	}
+
+	return &jwtTokenSource{
+		apiKey:   apiKey,
+		secret:   secret,
+		lifetime: lifetime,
+		method:   jwt.SigningMethodHS256,
+	}
+}
+
+// Token returns a signed project JWT, re-signing only once the cached token
+// is within a jittered window of its expiry.
+func (s *jwtTokenSource) Token() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.token) > 0 && time.Now().UTC().Before(s.expires) {
+		return s.token, nil
+	}
+
+	type tokboxClaims struct {
+		Ist string `json:"ist,omitempty"`
+		jwt.StandardClaims
+	}
+
+	now := time.Now().UTC()
+	claims := tokboxClaims{
+		"project",
+		jwt.StandardClaims{
+			Issuer:    s.apiKey,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(s.lifetime).Unix(),
+			Id:        uuid.NewV4().String(),
+		},
+	}
+
+	signed, err := jwt.NewWithClaims(s.method, claims).SignedString([]byte(s.secret))
+	if err != nil {
+		return "", err
+	}
+
+	// Refresh a bit before the token actually expires, jittered so that many
+	// concurrent clients sharing a lifetime don't all re-sign at once.
+	margin := s.lifetime / 10
+	refreshIn := s.lifetime - margin - time.Duration(rand.Int63n(int64(margin)+1))
+
+	s.token = signed
+	s.expires = now.Add(refreshIn)
+	return s.token, nil
+}
@@ -0,0 +1,83 @@
+package tokbox
+
+import (
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+func TestJWTTokenSourceDefaultExpiryWithin5Minutes(t *testing.T) {
+	src := newJWTTokenSource("key", "secret", 0)
+
+	tok, err := src.Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	parsed, _, err := new(jwt.Parser).ParseUnverified(tok, jwt.MapClaims{})
+	if err != nil {
+		t.Fatalf("expected a parsable JWT, got error: %v", err)
+	}
+
+	claims := parsed.Claims.(jwt.MapClaims)
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		t.Fatalf("expected an exp claim, got %v", claims["exp"])
+	}
+	iat, ok := claims["iat"].(float64)
+	if !ok {
+		t.Fatalf("expected an iat claim, got %v", claims["iat"])
+	}
+
+	lifetime := time.Duration(exp-iat) * time.Second
+	if lifetime > maxJWTLifetime {
+		t.Fatalf("expected JWT lifetime <= %v, got %v", maxJWTLifetime, lifetime)
+	}
+}
+
+func TestWithJWTLifetimeIsCappedAt5Minutes(t *testing.T) {
+	tb := New("key", "secret", WithJWTLifetime(time.Hour))
+
+	if tb.jwtSource.lifetime > maxJWTLifetime {
+		t.Fatalf("expected lifetime to be capped at %v, got %v", maxJWTLifetime, tb.jwtSource.lifetime)
+	}
+}
+
+func TestJWTTokenSourceReusesTokenUntilNearExpiry(t *testing.T) {
+	src := newJWTTokenSource("key", "secret", time.Minute)
+
+	first, err := src.Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	second, err := src.Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if first != second {
+		t.Fatal("expected Token to reuse the cached token before it nears expiry")
+	}
+}
+
+func TestJWTTokenSourceRefreshesAfterExpiry(t *testing.T) {
+	src := newJWTTokenSource("key", "secret", time.Minute)
+
+	first, err := src.Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	src.expires = time.Now().UTC().Add(-time.Second)
+
+	second, err := src.Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if first == second {
+		t.Fatal("expected Token to re-sign once the cached token is past its refresh window")
+	}
+}
@@ -0,0 +1,13 @@
+package tokbox
+
+// Session represents a Tokbox/OpenTok session, as returned by CreateSession.
+// See: https://tokbox.com/developer/guides/create-session/
+type Session struct {
+	SessionID string `json:"session_id"`
+	ProjectID string `json:"project_id"`
+	PartnerID string `json:"partner_id"` // Deprecated: use ProjectID instead
+
+	// T references back to the Tokbox client that created this session so
+	// session-scoped methods can make authenticated requests on its behalf.
+	T *Tokbox
+}
@@ -0,0 +1,101 @@
+package tokbox
+
+import (
+	"context"
+	"fmt"
+)
+
+const apiSessionBase = "/v2/project/%s/session/%s"
+
+// SignalPayload is the content sent to one or all connections in a session -
+// https://tokbox.com/developer/rest/#signaling
+type SignalPayload struct {
+	Type string `json:"type,omitempty"`
+	Data string `json:"data"`
+}
+
+// Signal sends a signal to a single connection, or to every connection in
+// the session when connectionID is empty -
+// https://tokbox.com/developer/rest/#signaling
+func (t *Tokbox) Signal(ctx context.Context, sessionID, connectionID string, payload SignalPayload) error {
+	if len(sessionID) == 0 {
+		return fmt.Errorf("Tokbox: sessionID is required to send a signal")
+	}
+
+	path := fmt.Sprintf(apiSessionBase, t.apiKey, sessionID) + "/signal"
+	if len(connectionID) > 0 {
+		path = fmt.Sprintf(apiSessionBase, t.apiKey, sessionID) + "/connection/" + connectionID + "/signal"
+	}
+
+	return t.doJSON(ctx, "POST", path, payload, nil)
+}
+
+// ForceDisconnect forces a client connection to leave the session -
+// https://tokbox.com/developer/rest/#forcing-clients-to-disconnect
+func (t *Tokbox) ForceDisconnect(ctx context.Context, sessionID, connectionID string) error {
+	if len(sessionID) == 0 {
+		return fmt.Errorf("Tokbox: sessionID is required to force disconnect")
+	}
+	if len(connectionID) == 0 {
+		return fmt.Errorf("Tokbox: connectionID is required to force disconnect")
+	}
+
+	path := fmt.Sprintf(apiSessionBase, t.apiKey, sessionID) + "/connection/" + connectionID
+	return t.doJSON(ctx, "DELETE", path, nil, nil)
+}
+
+// ForceMuteStream forces the publisher of a single stream to mute its audio -
+// https://tokbox.com/developer/rest/#force_mute_stream
+func (t *Tokbox) ForceMuteStream(ctx context.Context, sessionID, streamID string) error {
+	if len(sessionID) == 0 {
+		return fmt.Errorf("Tokbox: sessionID is required to force mute a stream")
+	}
+	if len(streamID) == 0 {
+		return fmt.Errorf("Tokbox: streamID is required to force mute a stream")
+	}
+
+	path := fmt.Sprintf(apiSessionBase, t.apiKey, sessionID) + "/stream/" + streamID + "/mute"
+	return t.doJSON(ctx, "POST", path, nil, nil)
+}
+
+// ForceMuteAllRequest excludes the listed stream IDs from a ForceMuteAll call
+type ForceMuteAllRequest struct {
+	ExcludedStreams []string
+}
+
+// ForceMuteAll forces all publishers in the session to mute their audio,
+// optionally excluding a list of streams -
+// https://tokbox.com/developer/rest/#force_mute_session
+func (t *Tokbox) ForceMuteAll(ctx context.Context, sessionID string, req *ForceMuteAllRequest) error {
+	if len(sessionID) == 0 {
+		return fmt.Errorf("Tokbox: sessionID is required to force mute a session")
+	}
+
+	body := struct {
+		Active          bool     `json:"active"`
+		ExcludedStreams []string `json:"excludedStreams,omitempty"`
+	}{Active: true}
+
+	if req != nil {
+		body.ExcludedStreams = req.ExcludedStreams
+	}
+
+	path := fmt.Sprintf(apiSessionBase, t.apiKey, sessionID) + "/mute"
+	return t.doJSON(ctx, "POST", path, body, nil)
+}
+
+// DisableForceMute lifts a previously applied ForceMuteAll, allowing
+// publishers to unmute themselves -
+// https://tokbox.com/developer/rest/#force_mute_session
+func (t *Tokbox) DisableForceMute(ctx context.Context, sessionID string) error {
+	if len(sessionID) == 0 {
+		return fmt.Errorf("Tokbox: sessionID is required to disable force mute")
+	}
+
+	body := struct {
+		Active bool `json:"active"`
+	}{Active: false}
+
+	path := fmt.Sprintf(apiSessionBase, t.apiKey, sessionID) + "/mute"
+	return t.doJSON(ctx, "POST", path, body, nil)
+}
@@ -0,0 +1,102 @@
+package tokbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSignalSessionWide(t *testing.T) {
+	var gotPath string
+	var gotBody SignalPayload
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatal(err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	tb := newTestClient(srv)
+	err := tb.Signal(context.Background(), "SESSIONID", "", SignalPayload{Type: "chat", Data: "hi"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := "/v2/project/key/session/SESSIONID/signal"; gotPath != want {
+		t.Fatalf("expected session-wide signal path %q, got %q", want, gotPath)
+	}
+	if gotBody.Type != "chat" || gotBody.Data != "hi" {
+		t.Fatalf("unexpected signal payload sent: %+v", gotBody)
+	}
+}
+
+func TestSignalSingleConnection(t *testing.T) {
+	var gotPath string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	tb := newTestClient(srv)
+	err := tb.Signal(context.Background(), "SESSIONID", "CONNECTIONID", SignalPayload{Data: "hi"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := "/v2/project/key/session/SESSIONID/connection/CONNECTIONID/signal"; gotPath != want {
+		t.Fatalf("expected per-connection signal path %q, got %q", want, gotPath)
+	}
+}
+
+func TestForceDisconnectRequiresConnectionID(t *testing.T) {
+	tb := New("key", "secret")
+
+	if err := tb.ForceDisconnect(context.Background(), "SESSIONID", ""); err == nil {
+		t.Fatal("expected an error when connectionID is empty, got nil")
+	}
+}
+
+func TestForceMuteAllExcludesStreams(t *testing.T) {
+	var gotBody map[string]interface{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatal(err)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	tb := newTestClient(srv)
+	err := tb.ForceMuteAll(context.Background(), "SESSIONID", &ForceMuteAllRequest{ExcludedStreams: []string{"STREAMID"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotBody["active"] != true {
+		t.Fatalf("expected active=true in request body, got %v", gotBody["active"])
+	}
+	excluded, ok := gotBody["excludedStreams"].([]interface{})
+	if !ok || len(excluded) != 1 || excluded[0] != "STREAMID" {
+		t.Fatalf("expected excludedStreams=[STREAMID] in request body, got %v", gotBody["excludedStreams"])
+	}
+}
+
+func TestDisableForceMuteErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	tb := newTestClient(srv)
+	if err := tb.DisableForceMute(context.Background(), "SESSIONID"); err == nil {
+		t.Fatal("expected an error for a 403 response, got nil")
+	}
+}
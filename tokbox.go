@@ -1,19 +1,14 @@
 package tokbox
 
 import (
-	"net/http"
-	"net/url"
-
+	"context"
 	"encoding/json"
-
 	"fmt"
+	"net/http"
+	"net/url"
+	"runtime"
 	"strings"
 	"time"
-
-	"golang.org/x/net/context"
-
-	jwt "github.com/dgrijalva/jwt-go"
-	"github.com/myesui/uuid"
 )
 
 const (
@@ -21,6 +16,11 @@ const (
 	apiSession = "/session/create"
 )
 
+// Version is the current version of this library. It is reported in the
+// default User-Agent header sent by a Tokbox client; a User-Agent set with
+// WithUserAgent replaces it.
+const Version = "2.0.0"
+
 const (
 	// Days30 represents 30 days duration in seconds
 	Days30 = 2592000 //30 * 24 * 60 * 60
@@ -70,6 +70,11 @@ func (i ArchiveMode) String() string {
 	return string(i)
 }
 
+// Logger is satisfied by *log.Logger and is used to report retried requests.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
 // Tokbox struct represents the REST API abstraction as a library
 type Tokbox struct {
 	apiKey        string
@@ -77,6 +82,46 @@ type Tokbox struct {
 
 	// BetaURL should be used to override the base url by the url from thee beta program.
 	BetaURL string
+
+	httpClient *http.Client
+	userAgent  string
+	retry      RetryPolicy
+	logger     Logger
+
+	jwtLifetime time.Duration
+	jwtSource   *jwtTokenSource
+}
+
+// Option configures a Tokbox client constructed with New.
+type Option func(*Tokbox)
+
+// WithHTTPClient sets the *http.Client used to perform requests. Defaults to
+// http.DefaultClient.
+func WithHTTPClient(c *http.Client) Option {
+	return func(t *Tokbox) { t.httpClient = c }
+}
+
+// WithBaseURL overrides the OpenTok API base URL, equivalent to setting
+// BetaURL directly.
+func WithBaseURL(url string) Option {
+	return func(t *Tokbox) { t.BetaURL = url }
+}
+
+// WithUserAgent overrides the User-Agent header sent with every request.
+func WithUserAgent(ua string) Option {
+	return func(t *Tokbox) { t.userAgent = ua }
+}
+
+// WithRetry sets the policy used to retry failed requests. Defaults to
+// DefaultRetryPolicy.
+func WithRetry(p RetryPolicy) Option {
+	return func(t *Tokbox) { t.retry = p }
+}
+
+// WithLogger sets a Logger used to report retried requests. Disabled by
+// default.
+func WithLogger(l Logger) Option {
+	return func(t *Tokbox) { t.logger = l }
 }
 
 // CreateSessionRequest provides all information needed by a session to be created
@@ -87,14 +132,39 @@ type CreateSessionRequest struct {
 }
 
 // New returns a new instance of Tokbox
-func New(apikey, partnerSecret string) *Tokbox {
-	return &Tokbox{apikey, partnerSecret, ""}
+func New(apiKey, partnerSecret string, opts ...Option) *Tokbox {
+	t := &Tokbox{
+		apiKey:        apiKey,
+		partnerSecret: partnerSecret,
+		httpClient:    http.DefaultClient,
+		retry:         DefaultRetryPolicy,
+	}
+
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	if len(t.userAgent) == 0 {
+		t.userAgent = defaultUserAgent()
+	}
+
+	t.jwtSource = newJWTTokenSource(apiKey, partnerSecret, t.jwtLifetime)
+
+	return t
+}
+
+// UserAgent returns the User-Agent string this client sends with every request.
+func (t *Tokbox) UserAgent() string {
+	return t.userAgent
+}
+
+func defaultUserAgent() string {
+	return fmt.Sprintf("tokbox-go/%s (%s/%s; %s)", Version, runtime.GOOS, runtime.GOARCH, runtime.Version())
 }
 
 // CreateSession creates a new tokbox session or returns an error.
 // See README file for full documentation: https://github.com/pjebs/tokbox
-// NOTE: ctx must be nil if *not* using Google App Engine
-func (t *Tokbox) CreateSession(req *CreateSessionRequest, ctx ...context.Context) (*Session, error) {
+func (t *Tokbox) CreateSession(ctx context.Context, req *CreateSessionRequest) (*Session, error) {
 	params := url.Values{}
 
 	if len(req.Location) > 0 {
@@ -116,6 +186,7 @@ func (t *Tokbox) CreateSession(req *CreateSessionRequest, ctx ...context.Context
 	if err != nil {
 		return nil, err
 	}
+	r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 
 	//Create jwt token
 	jwt, err := t.jwtToken()
@@ -126,10 +197,7 @@ func (t *Tokbox) CreateSession(req *CreateSessionRequest, ctx ...context.Context
 	r.Header.Add("Accept", "application/json")
 	r.Header.Add("X-OPENTOK-AUTH", jwt)
 
-	if len(ctx) == 0 {
-		ctx = append(ctx, nil)
-	}
-	res, err := client(ctx[0]).Do(r)
+	res, err := t.send(ctx, r)
 	if err != nil {
 		return nil, err
 	}
@@ -153,24 +221,10 @@ func (t *Tokbox) CreateSession(req *CreateSessionRequest, ctx ...context.Context
 	return &o, nil
 }
 
+// jwtToken returns the signed project JWT used to authenticate REST
+// requests, reusing a cached token from t.jwtSource until it nears expiry.
 func (t *Tokbox) jwtToken() (string, error) {
-
-	type TokboxClaims struct {
-		Ist string `json:"ist,omitempty"`
-		jwt.StandardClaims
-	}
-
-	claims := TokboxClaims{
-		"project",
-		jwt.StandardClaims{
-			Issuer:    t.apiKey,
-			IssuedAt:  time.Now().UTC().Unix(),
-			ExpiresAt: time.Now().UTC().Unix() + (2 * 24 * 60 * 60), // 2 hours; //NB: The maximum allowed expiration time range is 5 minutes.
-			Id:        uuid.NewV4().String(),
-		},
-	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(t.partnerSecret))
+	return t.jwtSource.Token()
 }
 
 func (t *Tokbox) endpoint() string {
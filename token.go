@@ -0,0 +1,163 @@
+package tokbox
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/myesui/uuid"
+)
+
+const maxConnectionDataLen = 1000
+
+// Role type - https://tokbox.com/developer/guides/create-token/#roles
+type Role string
+
+const (
+	// Subscriber can only subscribe to streams
+	Subscriber Role = "subscriber"
+	// Publisher can publish streams, subscribe to streams, and signal
+	Publisher Role = "publisher"
+	// Moderator can do the same as a publisher and can also moderate streams
+	Moderator Role = "moderator"
+)
+
+// String returns the string value of a Role instance
+func (i Role) String() string {
+	return string(i)
+}
+
+// TokenFormat selects the wire format of a token generated by Session.Token.
+type TokenFormat string
+
+const (
+	// TokenFormatLegacy produces the classic "T1==" base64 token format.
+	// This is the default when TokenOptions.Format is empty.
+	TokenFormatLegacy TokenFormat = "legacy"
+	// TokenFormatJWT produces a JWT session token, signed the same way as
+	// the project-level auth JWT.
+	TokenFormatJWT TokenFormat = "jwt"
+)
+
+// TokenOptions provides all information needed to generate a client token for
+// a Session - https://tokbox.com/developer/guides/create-token/
+type TokenOptions struct {
+	// Format selects the token's wire format. Defaults to TokenFormatLegacy.
+	Format TokenFormat
+	// Role defaults to Publisher when empty
+	Role Role
+	// ExpireTime defaults to 24 hours from now when zero. It cannot be more
+	// than 30 days from the time the token is created.
+	ExpireTime time.Time
+	// Data is passed through to clients connecting with this token. It
+	// cannot be longer than 1000 characters.
+	Data string
+	// InitialLayoutClassList sets the initial layout classes for the stream
+	// published with this token, for use in composed archives/broadcasts.
+	InitialLayoutClassList []string
+}
+
+// Token generates a client token that can be used to connect to the session -
+// https://tokbox.com/developer/guides/create-token/
+func (s *Session) Token(opts TokenOptions) (string, error) {
+	if s.T == nil {
+		return "", fmt.Errorf("Tokbox: session is not bound to a Tokbox client")
+	}
+
+	role := opts.Role
+	if len(role) == 0 {
+		role = Publisher
+	}
+	if role != Subscriber && role != Publisher && role != Moderator {
+		return "", fmt.Errorf("Tokbox: invalid role: %v", role)
+	}
+
+	if utf8.RuneCountInString(opts.Data) > maxConnectionDataLen {
+		return "", fmt.Errorf("Tokbox: connection data cannot exceed %v characters", maxConnectionDataLen)
+	}
+
+	now := time.Now().UTC()
+
+	expireTime := opts.ExpireTime
+	if expireTime.IsZero() {
+		expireTime = now.Add(24 * time.Hour)
+	}
+	if expireTime.Before(now) {
+		return "", fmt.Errorf("Tokbox: expireTime must be in the future")
+	}
+	if maxExpireTime := now.Add(time.Duration(Days30) * time.Second); expireTime.After(maxExpireTime) {
+		return "", fmt.Errorf("Tokbox: expireTime cannot be more than 30 days from now")
+	}
+
+	switch opts.Format {
+	case TokenFormatJWT:
+		return s.jwtSessionToken(role, expireTime, opts)
+	default:
+		return s.legacyToken(role, expireTime, opts)
+	}
+}
+
+// legacyToken produces the classic OpenTok "T1==" token format.
+func (s *Session) legacyToken(role Role, expireTime time.Time, opts TokenOptions) (string, error) {
+	createTime := time.Now().UTC()
+
+	dataParams := url.Values{}
+	dataParams.Add("session_id", s.SessionID)
+	dataParams.Add("create_time", strconv.FormatInt(createTime.Unix(), 10))
+	dataParams.Add("nonce", uuid.NewV4().String())
+	dataParams.Add("role", role.String())
+	dataParams.Add("expire_time", strconv.FormatInt(expireTime.Unix(), 10))
+	if len(opts.Data) > 0 {
+		dataParams.Add("connection_data", opts.Data)
+	}
+	if len(opts.InitialLayoutClassList) > 0 {
+		dataParams.Add("initial_layout_class_list", strings.Join(opts.InitialLayoutClassList, " "))
+	}
+	dataString := dataParams.Encode()
+
+	mac := hmac.New(sha1.New, []byte(s.T.partnerSecret))
+	mac.Write([]byte(dataString))
+	sig := hex.EncodeToString(mac.Sum(nil))
+
+	decoded := fmt.Sprintf("partner_id=%s&sig=%s:%s", s.T.apiKey, sig, dataString)
+	return "T1==" + base64.StdEncoding.EncodeToString([]byte(decoded)), nil
+}
+
+// jwtSessionToken produces a JWT session token, signed with the partner
+// secret the same way as the project-level auth JWT in jwt.go.
+func (s *Session) jwtSessionToken(role Role, expireTime time.Time, opts TokenOptions) (string, error) {
+	type sessionClaims struct {
+		SessionID              string `json:"session_id"`
+		Scope                  string `json:"scope"`
+		Role                   string `json:"role"`
+		InitialLayoutClassList string `json:"initial_layout_class_list,omitempty"`
+		ConnectionData         string `json:"connection_data,omitempty"`
+		jwt.StandardClaims
+	}
+
+	claims := sessionClaims{
+		SessionID:      s.SessionID,
+		Scope:          "session.connect",
+		Role:           role.String(),
+		ConnectionData: opts.Data,
+		StandardClaims: jwt.StandardClaims{
+			Issuer:    s.T.apiKey,
+			IssuedAt:  time.Now().UTC().Unix(),
+			ExpiresAt: expireTime.Unix(),
+			Id:        uuid.NewV4().String(),
+		},
+	}
+	if len(opts.InitialLayoutClassList) > 0 {
+		claims.InitialLayoutClassList = strings.Join(opts.InitialLayoutClassList, " ")
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.T.partnerSecret))
+}
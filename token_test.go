@@ -0,0 +1,59 @@
+package tokbox
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+func newTestSession() *Session {
+	return &Session{
+		SessionID: "SESSIONID",
+		T:         New("key", "secret"),
+	}
+}
+
+func TestTokenRejectsExpireTimeBeyond30Days(t *testing.T) {
+	s := newTestSession()
+
+	_, err := s.Token(TokenOptions{ExpireTime: time.Now().Add(31 * 24 * time.Hour)})
+	if err == nil {
+		t.Fatal("expected an error for an expireTime more than 30 days out, got nil")
+	}
+}
+
+func TestTokenAllowsExpireTimeWithin30Days(t *testing.T) {
+	s := newTestSession()
+
+	tok, err := s.Token(TokenOptions{ExpireTime: time.Now().Add(29 * 24 * time.Hour)})
+	if err != nil {
+		t.Fatalf("expected a token within the 30 day window to succeed, got: %v", err)
+	}
+	if !strings.HasPrefix(tok, "T1==") {
+		t.Fatalf("expected a legacy T1== token, got %q", tok)
+	}
+}
+
+func TestTokenJWTFormat(t *testing.T) {
+	s := newTestSession()
+
+	tok, err := s.Token(TokenOptions{Format: TokenFormatJWT, Role: Moderator})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	parsed, _, err := new(jwt.Parser).ParseUnverified(tok, jwt.MapClaims{})
+	if err != nil {
+		t.Fatalf("expected a parsable JWT, got error: %v", err)
+	}
+
+	claims := parsed.Claims.(jwt.MapClaims)
+	if claims["session_id"] != s.SessionID {
+		t.Errorf("expected session_id claim %q, got %v", s.SessionID, claims["session_id"])
+	}
+	if claims["role"] != Moderator.String() {
+		t.Errorf("expected role claim %q, got %v", Moderator.String(), claims["role"])
+	}
+}